@@ -2,7 +2,12 @@ package cache
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	mathrand "math/rand"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -10,156 +15,754 @@ import (
 
 const shieldExpiry = time.Second * 5
 
+// defaultGCConcurrency bounds how many shards the GC worker sweeps at once,
+// so that one very large or slow shard cannot hold up the others.
+const defaultGCConcurrency = 4
+
+// ErrWorkerNotRunning is returned by Fetch and FetchStale when StartWorker
+// has not been called, since without the worker running expired entries
+// and stale shields are never garbage collected.
+var ErrWorkerNotRunning = errors.New("cache: worker not running")
+
 type shieldEntry struct {
 	lastAccessed time.Time
 	mu           *sync.Mutex
+
+	// errorStreak counts consecutive fetchFunc failures for this key, used
+	// to grow the negative-cache TTL when ErrorBackoff is configured.
+	errorStreak int
 }
 
-type CacheEntry[T any] struct {
+// CacheEntry holds a cached value, or, when Err is non-nil, a negatively
+// cached fetchFunc failure.
+type CacheEntry[V any] struct {
 	Expires time.Time
-	Data    T
+	Data    V
+	Err     error
 }
 
-// ShieldedCache is embedded in-memory shielded cache.
-// "Shielded" means that duplicate requests will not be
-// processed, but instead, they will wait for an existing
-// request to be processed and get the cached result from it.
-type ShieldedCache[T any] struct {
-	objects   map[string]CacheEntry[T]
-	objectsMu sync.RWMutex
+// CacheStats reports cumulative counters for a Cache instance.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
 
-	shieldsMu sync.Mutex
-	shields   map[string]*shieldEntry
+// Cache is an embedded in-memory shielded cache keyed by any comparable
+// type K. "Shielded" means that duplicate requests for the same key will
+// not be processed, but instead, they will wait for an existing request to
+// be processed and get the cached result from it.
+//
+// Objects and shields are striped across shards keyed by KeyHasher(key), so
+// that Fetch calls for keys in different shards never contend on the same
+// lock.
+//
+// ShieldedCache is a thin string-keyed wrapper over Cache, preserved for the
+// cache's original API.
+type Cache[K comparable, V any] struct {
+	shards    []*shard[K, V]
+	keyHasher func(K) uint64
 
 	gcInterval    time.Duration
+	gcConcurrency int
 	workerRunning uint32
+
+	maxEntries        int
+	entryCount        int64 // atomic; total live entries across all shards
+	evictionPolicyNew func() EvictionPolicy[K]
+	onEvict           func(key K, entry CacheEntry[V])
+
+	negativeTTL  time.Duration
+	errorBackoff ErrorBackoff
+
+	instanceID string
+	eventBus   EventBus
+	keyCodec   *KeyCodec[K]
+
+	bgWG sync.WaitGroup
+
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+// ShieldedCache is the cache's original string-keyed API, preserved as a
+// thin wrapper around Cache now that keys can be any comparable type. All
+// of Cache's methods are available on it unchanged.
+type ShieldedCache[V any] struct {
+	*Cache[string, V]
+}
+
+// NewShieldedCache creates a string-keyed Cache, matching the cache's
+// original constructor signature, with a customizeable gc interval -
+// period between garbage collection of expired objects.
+func NewShieldedCache[V any](gcInterval time.Duration, opts ...Option[string, V]) *ShieldedCache[V] {
+	return &ShieldedCache[V]{Cache: NewCache[string, V](gcInterval, opts...)}
+}
+
+// ErrorBackoff configures exponential growth of the negative-cache TTL
+// across consecutive fetchFunc failures for the same key, so a persistently
+// broken upstream is retried less and less often instead of on every Fetch.
+type ErrorBackoff struct {
+	// Min is the backoff applied after the first failure. Zero disables
+	// backoff growth: every failure is cached for NegativeTTL (plus any
+	// Jitter).
+	Min time.Duration
+	// Max caps the backoff. Zero means unbounded growth.
+	Max time.Duration
+	// Jitter is a fraction (0-1) of the resulting negative-cache TTL (the
+	// larger of NegativeTTL and the computed backoff) added as random
+	// slack, to avoid synchronized retries across many callers.
+	Jitter float64
+}
+
+// KeyCodec lets a Cache keyed on a type other than string participate in an
+// EventBus, which carries invalidations as strings, by converting cache
+// keys to and from their wire representation.
+type KeyCodec[K comparable] struct {
+	Encode func(key K) string
+	Decode func(s string) (key K, ok bool)
+}
+
+// Option customizes a Cache at construction time.
+type Option[K comparable, V any] func(*Cache[K, V])
+
+// WithKeyHasher overrides how keys are hashed to a shard index. The default
+// hashes common key types (ints, strings, ...) directly and falls back to
+// hashing fmt.Sprint(key) for everything else.
+func WithKeyHasher[K comparable, V any](hasher func(K) uint64) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		s.keyHasher = hasher
+	}
+}
+
+// WithKeyCodec lets a non-string-keyed Cache use an EventBus by telling it
+// how to turn a key into the string an EventBus message carries, and back.
+func WithKeyCodec[K comparable, V any](codec KeyCodec[K]) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		s.keyCodec = &codec
+	}
+}
+
+// WithShardCount overrides the number of shards objects and shields are
+// striped across (default defaultShardCount). More shards reduce lock
+// contention under concurrent access at the cost of a little memory and,
+// since each shard keeps its own eviction order, a little precision in
+// which key MaxEntries picks as the victim.
+func WithShardCount[K comparable, V any](n int) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		if n > 0 {
+			s.shards = make([]*shard[K, V], n)
+		}
+	}
+}
+
+// WithGCConcurrency bounds how many shards the periodic GC sweep processes
+// in parallel (default defaultGCConcurrency). Raise it on a cache with many
+// shards and a tight gcInterval so a GC pass completes well within it.
+func WithGCConcurrency[K comparable, V any](n int) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		if n > 0 {
+			s.gcConcurrency = n
+		}
+	}
+}
+
+// WithMaxEntries bounds the cache to at most maxEntries live entries in
+// total, across every shard, using a fresh policy from newPolicy per shard
+// to choose a victim whenever an insert pushes the total over that bound.
+// Without this option the cache is unbounded, as it has always been. The
+// total is exact, but because each shard keeps its own independent
+// eviction order, the victim evicted to enforce it is chosen from
+// whichever shard the triggering insert landed on, not from a single
+// global LRU/LFU ordering across all shards.
+func WithMaxEntries[K comparable, V any](maxEntries int, newPolicy func() EvictionPolicy[K]) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		s.maxEntries = maxEntries
+		s.evictionPolicyNew = newPolicy
+	}
+}
+
+// WithNegativeCaching caches fetchFunc errors for ttl, so a persistently
+// failing upstream is not re-hit on every Fetch call. Pass a zero
+// ErrorBackoff to cache every failure for exactly ttl; otherwise the
+// effective TTL grows from backoff.Min towards backoff.Max with each
+// consecutive failure for the same key.
+func WithNegativeCaching[K comparable, V any](ttl time.Duration, backoff ErrorBackoff) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		s.negativeTTL = ttl
+		s.errorBackoff = backoff
+	}
+}
+
+// WithEventBus wires bus into the cache so that DeleteObject, Invalidate,
+// and Set publish the key they change, and so the cache subscribes to
+// invalidations from peer instances sharing bus and evicts the affected key
+// locally. The subscriber loop starts with StartWorker and stops with it.
+// For a Cache keyed on anything but string, also pass WithKeyCodec so keys
+// can be carried over the bus.
+func WithEventBus[K comparable, V any](bus EventBus) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		s.eventBus = bus
+	}
 }
 
-// NewShieldedCache creates a new ShieldedCache instance with a customizeable
-// gc interval - period between garbage collection of expired objects.
-func NewShieldedCache[T any](gcInterval time.Duration) *ShieldedCache[T] {
-	return &ShieldedCache[T]{
-		objects: make(map[string]CacheEntry[T]),
-		shields: make(map[string]*shieldEntry),
+// WithOnEvict registers a callback invoked whenever an entry is evicted,
+// whether by TTL expiry or by the eviction policy. It is not called for
+// explicit DeleteObject calls.
+func WithOnEvict[K comparable, V any](fn func(key K, entry CacheEntry[V])) Option[K, V] {
+	return func(s *Cache[K, V]) {
+		s.onEvict = fn
+	}
+}
+
+// NewCache creates a new Cache instance with a customizeable gc interval -
+// period between garbage collection of expired objects.
+func NewCache[K comparable, V any](gcInterval time.Duration, opts ...Option[K, V]) *Cache[K, V] {
+	s := &Cache[K, V]{
+		shards:    make([]*shard[K, V], defaultShardCount),
+		keyHasher: defaultKeyHasher[K],
+
+		gcInterval:    gcInterval,
+		gcConcurrency: defaultGCConcurrency,
+
+		evictionPolicyNew: func() EvictionPolicy[K] { return NewNoEvictionPolicy[K]() },
+		instanceID:        newInstanceID(),
+	}
+
+	var zeroKey K
+	if _, ok := any(zeroKey).(string); ok {
+		// Keys are strings; they can travel over an EventBus as-is without
+		// requiring a WithKeyCodec.
+		s.keyCodec = &KeyCodec[K]{
+			Encode: func(key K) string { return any(key).(string) },
+			Decode: func(str string) (K, bool) { return any(str).(K), true },
+		}
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
 
-		gcInterval: gcInterval,
+	for i := range s.shards {
+		s.shards[i] = newShard[K, V](s.evictionPolicyNew)
 	}
+
+	return s
+}
+
+// newInstanceID generates a unique per-instance identifier used to let an
+// EventBus subscriber tell apart invalidations this instance published
+// itself from ones published by peers.
+func newInstanceID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
 }
 
 // Fetch is the main read-write cache that acts as a middleware between actual fetch function
 // and the application, creating a cache layer in between.
-func (s *ShieldedCache[T]) Fetch(key string, ttl time.Duration, fetchFunc func() (T, error)) (*CacheEntry[T], bool, error) {
+func (s *Cache[K, V]) Fetch(key K, ttl time.Duration, fetchFunc func() (V, error)) (*CacheEntry[V], bool, error) {
 	if atomic.LoadUint32(&s.workerRunning) == 0 {
 		// Ensuring that the worker is running to prevent
 		// possible memory leak as GC not being active.
 		return nil, false, ErrWorkerNotRunning
 	}
 
-	s.shieldsMu.Lock()
-	shield := s.shields[key]
+	sh := s.shardFor(key)
+
+	sh.shieldsMu.Lock()
+	shield := sh.shields[key]
 	if shield == nil {
 		shield = &shieldEntry{
 			lastAccessed: time.Now(),
 			mu:           new(sync.Mutex),
 		}
-		s.shields[key] = shield
+		sh.shields[key] = shield
 	}
-	s.shieldsMu.Unlock()
+	sh.shieldsMu.Unlock()
 
 	shield.mu.Lock()
 	defer shield.mu.Unlock()
 
-	s.objectsMu.RLock()
-	res, ok := s.objects[key]
-	s.objectsMu.RUnlock()
+	sh.mu.Lock()
+	res, ok := sh.objects[key]
+	if ok {
+		sh.evictionPolicy.Touch(key)
+	}
+	sh.mu.Unlock()
+
+	if ok {
+		atomic.AddUint64(&s.hits, 1)
+		return &res, true, res.Err
+	}
+
+	return s.fetchAndCache(sh, key, ttl, shield, fetchFunc)
+}
+
+// FetchStale behaves like Fetch, except once a cached entry's remaining TTL
+// drops below refreshAhead, it is returned immediately while a single
+// background goroutine repopulates it via fetchFunc. A refreshAhead of zero
+// disables the stale-while-revalidate behavior, making this equivalent to
+// Fetch. Concurrent Fetch or FetchStale calls for the same key coalesce
+// with an in-flight background refresh via the same shield used for
+// synchronous fetches.
+func (s *Cache[K, V]) FetchStale(key K, ttl time.Duration, refreshAhead time.Duration, fetchFunc func() (V, error)) (*CacheEntry[V], bool, error) {
+	if atomic.LoadUint32(&s.workerRunning) == 0 {
+		// Ensuring that the worker is running to prevent
+		// possible memory leak as GC not being active.
+		return nil, false, ErrWorkerNotRunning
+	}
+
+	sh := s.shardFor(key)
+
+	sh.shieldsMu.Lock()
+	shield := sh.shields[key]
+	if shield == nil {
+		shield = &shieldEntry{
+			lastAccessed: time.Now(),
+			mu:           new(sync.Mutex),
+		}
+		sh.shields[key] = shield
+	}
+	sh.shieldsMu.Unlock()
+
+	shield.mu.Lock()
+
+	sh.mu.Lock()
+	res, ok := sh.objects[key]
+	if ok {
+		sh.evictionPolicy.Touch(key)
+	}
+	sh.mu.Unlock()
 
 	if ok {
-		return &res, true, nil
+		atomic.AddUint64(&s.hits, 1)
+
+		if refreshAhead > 0 && res.Err == nil && time.Until(res.Expires) < refreshAhead {
+			// Hand the shield lock off to the background refresh instead of
+			// releasing it here, so any concurrent Fetch/FetchStale on this
+			// key coalesces with the refresh rather than racing it.
+			s.bgWG.Add(1)
+			go s.backgroundRefresh(sh, key, ttl, shield, fetchFunc)
+		} else {
+			shield.mu.Unlock()
+		}
+
+		return &res, true, res.Err
+	}
+
+	defer shield.mu.Unlock()
+
+	return s.fetchAndCache(sh, key, ttl, shield, fetchFunc)
+}
+
+// backgroundRefresh repopulates key via fetchFunc on behalf of FetchStale.
+// It owns shield.mu on entry and releases it when done.
+func (s *Cache[K, V]) backgroundRefresh(sh *shard[K, V], key K, ttl time.Duration, shield *shieldEntry, fetchFunc func() (V, error)) {
+	defer s.bgWG.Done()
+	defer shield.mu.Unlock()
+
+	if atomic.LoadUint32(&s.workerRunning) == 0 {
+		// The worker was shut down while this refresh was queued; respect
+		// that rather than repopulating a cache nothing will garbage-collect.
+		return
 	}
 
+	s.fetchAndCache(sh, key, ttl, shield, fetchFunc)
+}
+
+// fetchAndCache calls fetchFunc, caches its result (or, with negative
+// caching enabled, its error) in sh and evicts a victim from sh if the cache
+// is now over MaxEntries. Callers must hold shield.mu.
+func (s *Cache[K, V]) fetchAndCache(sh *shard[K, V], key K, ttl time.Duration, shield *shieldEntry, fetchFunc func() (V, error)) (*CacheEntry[V], bool, error) {
+	atomic.AddUint64(&s.misses, 1)
+
 	ret, err := fetchFunc()
 	if err != nil {
+		if s.negativeTTL <= 0 {
+			return nil, false, err
+		}
+
+		res := CacheEntry[V]{
+			Expires: time.Now().Add(s.negativeTTLFor(shield.errorStreak)),
+			Err:     err,
+		}
+		shield.errorStreak++
+
+		sh.mu.Lock()
+		s.setObject(sh, key, res)
+		sh.evictionPolicy.Touch(key)
+		victimKey, victim, evicted := s.evict(sh)
+		sh.mu.Unlock()
+
+		if evicted {
+			s.notifyEvict(victimKey, victim)
+		}
+
 		return nil, false, err
 	}
+	shield.errorStreak = 0
 
-	res = CacheEntry[T]{
+	res := CacheEntry[V]{
 		Expires: time.Now().Add(ttl),
 		Data:    ret,
 	}
 
-	s.objectsMu.Lock()
-	s.objects[key] = res
-	s.objectsMu.Unlock()
+	sh.mu.Lock()
+	s.setObject(sh, key, res)
+	sh.evictionPolicy.Touch(key)
+	victimKey, victim, evicted := s.evict(sh)
+	sh.mu.Unlock()
+
+	if evicted {
+		s.notifyEvict(victimKey, victim)
+	}
 
 	return &res, false, nil
 }
 
+// setObject inserts or overwrites key in sh, keeping s.entryCount - the
+// total live entry count across all shards that evict uses to enforce
+// MaxEntries - in sync. Callers must hold sh.mu.
+func (s *Cache[K, V]) setObject(sh *shard[K, V], key K, entry CacheEntry[V]) {
+	if _, existed := sh.objects[key]; !existed {
+		atomic.AddInt64(&s.entryCount, 1)
+	}
+	sh.objects[key] = entry
+}
+
+// negativeTTLFor computes the negative-cache TTL for a key that has failed
+// errorStreak times in a row, applying ErrorBackoff growth and jitter on
+// top of the configured NegativeTTL floor.
+func (s *Cache[K, V]) negativeTTLFor(errorStreak int) time.Duration {
+	ttl := s.negativeTTL
+
+	if s.errorBackoff.Min > 0 {
+		backoff := s.errorBackoff.Min
+		for i := 0; i < errorStreak; i++ {
+			if s.errorBackoff.Max > 0 && backoff >= s.errorBackoff.Max {
+				backoff = s.errorBackoff.Max
+				break
+			}
+			// Stop doubling once another doubling would overflow
+			// time.Duration's int64 range, rather than letting backoff *=
+			// 2 wrap around to a small or negative value and silently
+			// collapse the TTL back down to the floor.
+			if backoff > time.Duration(math.MaxInt64)/2 {
+				break
+			}
+			backoff *= 2
+		}
+		if s.errorBackoff.Max > 0 && backoff > s.errorBackoff.Max {
+			backoff = s.errorBackoff.Max
+		}
+		if backoff > ttl {
+			ttl = backoff
+		}
+	}
+
+	if s.errorBackoff.Jitter > 0 {
+		ttl += time.Duration(mathrand.Float64() * s.errorBackoff.Jitter * float64(ttl))
+	}
+
+	return ttl
+}
+
+// Refresh forces the next Fetch for key to bypass any cached value,
+// including a cached negative result, and call fetchFunc again.
+func (s *Cache[K, V]) Refresh(key K) {
+	sh := s.shardFor(key)
+
+	s.deleteLocal(sh, key)
+
+	sh.shieldsMu.Lock()
+	shield, ok := sh.shields[key]
+	sh.shieldsMu.Unlock()
+
+	if ok {
+		shield.mu.Lock()
+		shield.errorStreak = 0
+		shield.mu.Unlock()
+	}
+}
+
+// evict removes sh's eviction policy's chosen victim if the cache's total
+// entry count, across all shards, is over MaxEntries. It must be called
+// with sh.mu held for writing.
+func (s *Cache[K, V]) evict(sh *shard[K, V]) (key K, entry CacheEntry[V], ok bool) {
+	if s.maxEntries <= 0 || atomic.LoadInt64(&s.entryCount) <= int64(s.maxEntries) {
+		var zeroKey K
+		return zeroKey, CacheEntry[V]{}, false
+	}
+
+	key, ok = sh.evictionPolicy.Evict()
+	if !ok {
+		var zeroKey K
+		return zeroKey, CacheEntry[V]{}, false
+	}
+
+	entry = sh.objects[key]
+	delete(sh.objects, key)
+	atomic.AddUint64(&s.evictions, 1)
+	atomic.AddInt64(&s.entryCount, -1)
+
+	return key, entry, true
+}
+
+// notifyEvict calls the configured OnEvict callback, if any, outside of any
+// shard lock so callers may safely re-enter the cache from it.
+func (s *Cache[K, V]) notifyEvict(key K, entry CacheEntry[V]) {
+	if s.onEvict != nil {
+		s.onEvict(key, entry)
+	}
+}
+
 // StartWorker starts the worker, the goroutine that periodically evicts
-// expired objects in the cache. The GC interval is configured in ShieldedCache creation.
-func (s *ShieldedCache[T]) StartWorker(ctx context.Context) error {
+// expired objects in the cache. The GC interval is configured in Cache
+// creation. If an EventBus is configured, StartWorker also does not return
+// until its subscription is confirmed live, so a Fetch/DeleteObject on a
+// peer instance immediately afterwards cannot race past it unnoticed. It
+// returns without that confirmation if ctx is canceled first or if
+// Subscribe fails; in the latter case it still returns nil.
+func (s *Cache[K, V]) StartWorker(ctx context.Context) error {
 	if atomic.LoadUint32(&s.workerRunning) == 1 {
 		return fmt.Errorf("worker already running")
 	}
 
+	if s.eventBus != nil && s.keyCodec == nil {
+		return fmt.Errorf("event bus configured without a key codec: use WithKeyCodec for non-string keys")
+	}
+
 	atomic.StoreUint32(&s.workerRunning, 1)
 
 	go s.runWorker(ctx)
 
+	if s.eventBus != nil {
+		ready := make(chan struct{})
+		s.bgWG.Add(1)
+		go s.runEventSubscriber(ctx, ready)
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+		}
+	}
+
 	return nil
 }
 
-func (s *ShieldedCache[T]) runWorker(ctx context.Context) {
+// runEventSubscriber relays invalidations from peer instances on s.eventBus
+// into local evictions, until ctx is canceled. ready, if non-nil, is closed
+// once the subscription is registered with s.eventBus.
+func (s *Cache[K, V]) runEventSubscriber(ctx context.Context, ready chan struct{}) {
+	defer s.bgWG.Done()
+	defer func() {
+		// If Subscribe returned without ever closing ready (e.g. it failed
+		// before confirming registration), unblock StartWorker anyway
+		// rather than leaving it waiting on a subscription that will
+		// never come up.
+		select {
+		case <-ready:
+		default:
+			close(ready)
+		}
+	}()
+
+	_ = s.eventBus.Subscribe(ctx, func(origin, encodedKey string) {
+		if origin == s.instanceID {
+			// Our own publish; already applied locally.
+			return
+		}
+
+		key, ok := s.keyCodec.Decode(encodedKey)
+		if !ok {
+			return
+		}
+
+		s.deleteLocal(s.shardFor(key), key)
+	}, ready)
+}
+
+func (s *Cache[K, V]) runWorker(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			s.objectsMu.Lock()
-			for key := range s.objects {
-				delete(s.objects, key)
-			}
-			s.objectsMu.Unlock()
-			s.shieldsMu.Lock()
-			for key := range s.shields {
-				delete(s.shields, key)
+			// Let any in-flight FetchStale background refreshes finish
+			// before tearing down the maps they write to.
+			s.bgWG.Wait()
+			for _, sh := range s.shards {
+				sh.mu.Lock()
+				atomic.AddInt64(&s.entryCount, -int64(len(sh.objects)))
+				for key := range sh.objects {
+					delete(sh.objects, key)
+					sh.evictionPolicy.Remove(key)
+				}
+				sh.mu.Unlock()
+
+				sh.shieldsMu.Lock()
+				for key := range sh.shields {
+					delete(sh.shields, key)
+				}
+				sh.shieldsMu.Unlock()
 			}
-			s.shieldsMu.Unlock()
 			atomic.StoreUint32(&s.workerRunning, 0)
 			return
 		case <-time.After(s.gcInterval):
-			s.objectsMu.Lock()
-			for key, item := range s.objects {
-				if item.Expires.Before(time.Now()) {
-					// Object expired
-					delete(s.objects, key)
-				}
-			}
-			s.objectsMu.Unlock()
-			s.shieldsMu.Lock()
-			for key, shield := range s.shields {
-				if shield.lastAccessed.Add(shieldExpiry).Before(time.Now()) {
-					delete(s.shields, key)
-				}
+			s.gcPass()
+		}
+	}
+}
+
+// gcPass sweeps every shard for expired objects and stale shields, using up
+// to gcConcurrency workers so a long sweep of one shard does not delay
+// Fetch calls against the others.
+func (s *Cache[K, V]) gcPass() {
+	workers := s.gcConcurrency
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(s.shards) {
+		workers = len(s.shards)
+	}
+
+	shardCh := make(chan *shard[K, V])
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for sh := range shardCh {
+				s.gcShard(sh)
 			}
-			s.shieldsMu.Unlock()
+		}()
+	}
+
+	for _, sh := range s.shards {
+		shardCh <- sh
+	}
+	close(shardCh)
+
+	wg.Wait()
+}
+
+// gcShard evicts expired objects and stale shields from a single shard.
+func (s *Cache[K, V]) gcShard(sh *shard[K, V]) {
+	sh.mu.Lock()
+	var expiredKeys []K
+	var expiredEntries []CacheEntry[V]
+	for key, item := range sh.objects {
+		if item.Expires.Before(time.Now()) {
+			// Object expired
+			delete(sh.objects, key)
+			sh.evictionPolicy.Remove(key)
+			expiredKeys = append(expiredKeys, key)
+			expiredEntries = append(expiredEntries, item)
+		}
+	}
+	sh.mu.Unlock()
+
+	if len(expiredKeys) > 0 {
+		atomic.AddUint64(&s.evictions, uint64(len(expiredKeys)))
+		atomic.AddInt64(&s.entryCount, -int64(len(expiredKeys)))
+		for i, key := range expiredKeys {
+			s.notifyEvict(key, expiredEntries[i])
 		}
 	}
+
+	sh.shieldsMu.Lock()
+	for key, shield := range sh.shields {
+		if shield.lastAccessed.Add(shieldExpiry).Before(time.Now()) {
+			delete(sh.shields, key)
+		}
+	}
+	sh.shieldsMu.Unlock()
 }
 
-// Usage returns the size of underlying maps for objects, and shields.
-func (s *ShieldedCache[T]) Usage() (int, int) {
-	s.objectsMu.RLock()
-	objectsLen := len(s.objects)
-	s.objectsMu.RUnlock()
+// Usage returns the size of underlying maps for objects, and shields,
+// summed across all shards.
+func (s *Cache[K, V]) Usage() (int, int) {
+	var objectsLen, shieldsLen int
 
-	s.shieldsMu.Lock()
-	shieldsLen := len(s.shields)
-	s.shieldsMu.Unlock()
+	for _, sh := range s.shards {
+		sh.mu.RLock()
+		objectsLen += len(sh.objects)
+		sh.mu.RUnlock()
+
+		sh.shieldsMu.Lock()
+		shieldsLen += len(sh.shields)
+		sh.shieldsMu.Unlock()
+	}
 
 	return objectsLen, shieldsLen
 }
 
-func (s *ShieldedCache[T]) DeleteObject(key string) {
-	s.objectsMu.Lock()
-	defer s.objectsMu.Unlock()
+// Stats returns cumulative hit/miss/eviction counters for the cache.
+func (s *Cache[K, V]) Stats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&s.hits),
+		Misses:    atomic.LoadUint64(&s.misses),
+		Evictions: atomic.LoadUint64(&s.evictions),
+	}
+}
+
+// DeleteObject removes key from the local cache and, if an EventBus is
+// configured, publishes the invalidation so peer instances evict it too.
+func (s *Cache[K, V]) DeleteObject(key K) {
+	s.deleteLocal(s.shardFor(key), key)
+	s.publishInvalidate(key)
+}
+
+// Invalidate is an alias for DeleteObject, named for the distributed
+// cache-coherence use case of evicting key everywhere it is cached.
+func (s *Cache[K, V]) Invalidate(key K) {
+	s.DeleteObject(key)
+}
+
+// Set stores value under key directly, bypassing fetchFunc, and - if an
+// EventBus is configured - publishes the change so peer instances evict
+// their own copy of key.
+func (s *Cache[K, V]) Set(key K, ttl time.Duration, value V) {
+	sh := s.shardFor(key)
+
+	res := CacheEntry[V]{
+		Expires: time.Now().Add(ttl),
+		Data:    value,
+	}
+
+	sh.mu.Lock()
+	s.setObject(sh, key, res)
+	sh.evictionPolicy.Touch(key)
+	victimKey, victim, evicted := s.evict(sh)
+	sh.mu.Unlock()
+
+	if evicted {
+		s.notifyEvict(victimKey, victim)
+	}
+
+	s.publishInvalidate(key)
+}
+
+// deleteLocal removes key from sh only, without publishing to the
+// EventBus.
+func (s *Cache[K, V]) deleteLocal(sh *shard[K, V], key K) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if _, existed := sh.objects[key]; existed {
+		delete(sh.objects, key)
+		atomic.AddInt64(&s.entryCount, -1)
+	}
+	sh.evictionPolicy.Remove(key)
+}
+
+// publishInvalidate announces key to the configured EventBus, if any. It is
+// best-effort: peers still converge on their own TTL expiry if this fails.
+func (s *Cache[K, V]) publishInvalidate(key K) {
+	if s.eventBus == nil || s.keyCodec == nil {
+		return
+	}
 
-	delete(s.objects, key)
+	_ = s.eventBus.Publish(context.Background(), s.instanceID, s.keyCodec.Encode(key))
 }
@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// BenchmarkShieldedCacheFetchParallel compares Fetch throughput under
+// concurrent access across a range of shard counts. shards=1 reproduces the
+// pre-sharding behavior of a single global objects map and shields map;
+// higher shard counts show the contention relief sharding provides.
+func BenchmarkShieldedCacheFetchParallel(b *testing.B) {
+	for _, shards := range []int{1, 16, 256} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			c := NewShieldedCache[int](time.Minute, WithShardCount[string, int](shards))
+			if err := c.StartWorker(ctx); err != nil {
+				b.Fatal(err)
+			}
+
+			var keyCounter uint64
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					n := atomic.AddUint64(&keyCounter, 1)
+					key := strconv.FormatUint(n%1000, 10)
+					_, _, _ = c.Fetch(key, time.Minute, func() (int, error) {
+						return int(n), nil
+					})
+				}
+			})
+		})
+	}
+}
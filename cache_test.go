@@ -3,6 +3,8 @@ package cache
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -90,6 +92,289 @@ func TestShieldedCacheTTL(t *testing.T) {
 	require.Error(err)
 }
 
+func TestNegativeCachingBackoffAndRefresh(t *testing.T) {
+	require := require.New(t)
+
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	c := NewShieldedCache[int](time.Millisecond*50,
+		WithNegativeCaching[string, int](time.Millisecond*200, ErrorBackoff{Min: time.Millisecond * 200, Max: time.Second}),
+	)
+
+	defer func() {
+		ctxCancel()
+		goleak.VerifyNone(t)
+		verifyEmptyCache(c, t)
+	}()
+
+	require.NoError(c.StartWorker(ctx))
+
+	var fetches int
+	fetchFunc := func() (int, error) {
+		fetches++
+		return 0, fmt.Errorf("boom")
+	}
+
+	_, hit, err := c.Fetch("k", time.Second, fetchFunc)
+	require.False(hit)
+	require.Error(err)
+	require.Equal(1, fetches)
+
+	// The negative result is cached; a Fetch before it expires must not
+	// call fetchFunc again.
+	_, hit, err = c.Fetch("k", time.Second, fetchFunc)
+	require.True(hit)
+	require.Error(err)
+	require.Equal(1, fetches)
+
+	// Refresh bypasses the cached negative result.
+	c.Refresh("k")
+	_, hit, err = c.Fetch("k", time.Second, fetchFunc)
+	require.False(hit)
+	require.Error(err)
+	require.Equal(2, fetches)
+}
+
+func TestNegativeTTLForBackoffSaturatesWithoutOverflow(t *testing.T) {
+	require := require.New(t)
+
+	c := NewShieldedCache[int](time.Minute,
+		WithNegativeCaching[string, int](time.Second, ErrorBackoff{Min: time.Second}),
+	)
+
+	// With Max unset ("unbounded growth"), a long error streak must
+	// saturate near the largest representable Duration instead of
+	// overflowing back down to something close to the 1s floor.
+	ttl := c.negativeTTLFor(1000)
+	require.Greater(ttl, time.Hour*24*365)
+
+	c2 := NewShieldedCache[int](time.Minute,
+		WithNegativeCaching[string, int](time.Second, ErrorBackoff{Min: time.Second, Max: time.Minute}),
+	)
+	require.Equal(time.Minute, c2.negativeTTLFor(1000))
+}
+
+func TestFetchStaleBackgroundRefresh(t *testing.T) {
+	require := require.New(t)
+
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	c := NewShieldedCache[int](time.Millisecond * 50)
+
+	defer func() {
+		ctxCancel()
+		goleak.VerifyNone(t)
+		verifyEmptyCache(c, t)
+	}()
+
+	require.NoError(c.StartWorker(ctx))
+
+	var fetches int32
+	fetchFunc := func() (int, error) {
+		return int(atomic.AddInt32(&fetches, 1)), nil
+	}
+
+	res, hit, err := c.FetchStale("k", time.Millisecond*200, time.Millisecond*150, fetchFunc)
+	require.False(hit)
+	require.NoError(err)
+	require.Equal(1, res.Data)
+
+	// Within refreshAhead of expiry: still served from the cached entry,
+	// but a background refresh should now be in flight.
+	time.Sleep(time.Millisecond * 100)
+	res, hit, err = c.FetchStale("k", time.Millisecond*200, time.Millisecond*150, fetchFunc)
+	require.True(hit)
+	require.NoError(err)
+	require.Equal(1, res.Data)
+
+	require.Eventually(func() bool {
+		return atomic.LoadInt32(&fetches) == 2
+	}, time.Second, time.Millisecond*10)
+
+	res, hit, err = c.FetchStale("k", time.Millisecond*200, time.Millisecond*150, fetchFunc)
+	require.True(hit)
+	require.NoError(err)
+	require.Equal(2, res.Data)
+}
+
+func TestEventBusInvalidatesPeer(t *testing.T) {
+	require := require.New(t)
+
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	bus := NewInProcEventBus()
+
+	a := NewShieldedCache[int](time.Millisecond*50, WithEventBus[string, int](bus))
+	b := NewShieldedCache[int](time.Millisecond*50, WithEventBus[string, int](bus))
+
+	defer func() {
+		ctxCancel()
+		goleak.VerifyNone(t)
+		verifyEmptyCache(a, t)
+		verifyEmptyCache(b, t)
+	}()
+
+	// StartWorker only returns once each cache's subscription to bus is
+	// live, so the DeleteObject below cannot race past b's subscriber
+	// setup.
+	require.NoError(a.StartWorker(ctx))
+	require.NoError(b.StartWorker(ctx))
+
+	fetchFunc := func() (int, error) { return 1, nil }
+
+	_, _, err := a.Fetch("k", time.Minute, fetchFunc)
+	require.NoError(err)
+	_, _, err = b.Fetch("k", time.Minute, fetchFunc)
+	require.NoError(err)
+
+	o, _ := b.Usage()
+	require.Equal(1, o)
+
+	a.DeleteObject("k")
+
+	require.Eventually(func() bool {
+		o, _ := b.Usage()
+		return o == 0
+	}, time.Second, time.Millisecond*5)
+}
+
+func TestCacheMaxEntriesIsGlobal(t *testing.T) {
+	require := require.New(t)
+
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	var evictions int
+	c := NewShieldedCache[int](time.Minute,
+		WithMaxEntries[string, int](3, func() EvictionPolicy[string] { return NewLRUPolicy[string]() }),
+		WithOnEvict[string, int](func(key string, entry CacheEntry[int]) { evictions++ }),
+	)
+
+	defer func() {
+		ctxCancel()
+		goleak.VerifyNone(t)
+		verifyEmptyCache(c, t)
+	}()
+
+	require.NoError(c.StartWorker(ctx))
+
+	fetchFunc := func() (int, error) { return 0, nil }
+
+	// Five distinct keys spread across the default 256 shards; the bound
+	// must hold overall regardless of how few of them collide into the
+	// same shard.
+	for i := 0; i < 5; i++ {
+		_, _, err := c.Fetch(fmt.Sprintf("key-%d", i), time.Minute, fetchFunc)
+		require.NoError(err)
+	}
+
+	o, _ := c.Usage()
+	require.Equal(3, o)
+	require.Equal(2, evictions)
+}
+
+func TestFetchTouchDeleteRaceKeepsEntryCountConsistent(t *testing.T) {
+	require := require.New(t)
+
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	c := NewShieldedCache[int](time.Millisecond*50,
+		WithMaxEntries[string, int](2, func() EvictionPolicy[string] { return NewLRUPolicy[string]() }),
+	)
+
+	defer func() {
+		ctxCancel()
+		goleak.VerifyNone(t)
+		verifyEmptyCache(c, t)
+	}()
+
+	require.NoError(c.StartWorker(ctx))
+
+	fetchFunc := func() (int, error) { return 0, nil }
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	// Hammer "k" with concurrent Fetch (touches the eviction policy) racing
+	// DeleteObject (removes it from sh.objects), while the main goroutine
+	// keeps pushing other keys over MaxEntries so eviction runs and can
+	// pick "k" as a victim mid-race.
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_, _, _ = c.Fetch("k", time.Minute, fetchFunc)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				c.DeleteObject("k")
+			}
+		}
+	}()
+
+	for i := 0; i < 2000; i++ {
+		_, _, err := c.Fetch(fmt.Sprintf("filler-%d", i), time.Minute, fetchFunc)
+		require.NoError(err)
+	}
+
+	close(stop)
+	wg.Wait()
+
+	c.DeleteObject("k")
+	for i := 0; i < 2000; i++ {
+		c.DeleteObject(fmt.Sprintf("filler-%d", i))
+	}
+
+	// Every key inserted above has now been explicitly deleted; if Touch
+	// and the object-presence check ever ran as separate critical
+	// sections, a deleted key's phantom eviction-policy entry could still
+	// be chosen as a victim and decrement entryCount for an object that
+	// was never actually in sh.objects, drifting it away from zero.
+	require.Equal(int64(0), c.entryCount)
+}
+
+func TestCacheIntKeys(t *testing.T) {
+	require := require.New(t)
+
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	c := NewCache[int, string](time.Millisecond * 100)
+
+	defer func() {
+		ctxCancel()
+		goleak.VerifyNone(t)
+		verifyEmptyCache(c, t)
+	}()
+
+	require.NoError(c.StartWorker(ctx))
+
+	var fetches int
+	fetchFunc := func() (string, error) {
+		fetches++
+		return "value", nil
+	}
+
+	res, hit, err := c.Fetch(42, time.Second, fetchFunc)
+	require.False(hit)
+	require.NoError(err)
+	require.Equal("value", res.Data)
+
+	res, hit, err = c.Fetch(42, time.Second, fetchFunc)
+	require.True(hit)
+	require.NoError(err)
+	require.Equal(1, fetches)
+}
+
 func TestShieldedCacheShielding(t *testing.T) {
 	require := require.New(t)
 
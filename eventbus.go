@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// EventBus lets a ShieldedCache announce key invalidations to, and receive
+// them from, other ShieldedCache instances sharing the same data - whether
+// in the same process or across a cluster - so that deleting or setting a
+// key on one instance evicts it everywhere.
+type EventBus interface {
+	// Publish announces that origin invalidated key.
+	Publish(ctx context.Context, origin string, key string) error
+	// Subscribe delivers invalidations to handler until ctx is canceled or
+	// the bus is closed, at which point it returns. If ready is non-nil,
+	// Subscribe closes it once the subscription is registered and able to
+	// observe a Publish call made after ready closes, so callers can wait
+	// out the "start then use" race instead of possibly missing early
+	// invalidations.
+	Subscribe(ctx context.Context, handler func(origin string, key string), ready chan<- struct{}) error
+}
+
+type inProcEvent struct {
+	origin string
+	key    string
+}
+
+// InProcEventBus is an EventBus that fans invalidations out to every
+// subscriber within the same process. It is mainly useful for tests and for
+// wiring up several ShieldedCache instances in one binary.
+type InProcEventBus struct {
+	mu   sync.Mutex
+	subs map[int]chan inProcEvent
+	next int
+}
+
+// NewInProcEventBus creates an empty in-process EventBus.
+func NewInProcEventBus() *InProcEventBus {
+	return &InProcEventBus{subs: make(map[int]chan inProcEvent)}
+}
+
+func (b *InProcEventBus) Publish(ctx context.Context, origin string, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- inProcEvent{origin: origin, key: key}:
+		default:
+			// Slow subscriber; drop rather than block the publisher.
+		}
+	}
+
+	return nil
+}
+
+func (b *InProcEventBus) Subscribe(ctx context.Context, handler func(origin string, key string), ready chan<- struct{}) error {
+	ch := make(chan inProcEvent, 64)
+
+	b.mu.Lock()
+	id := b.next
+	b.next++
+	b.subs[id] = ch
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.subs, id)
+		b.mu.Unlock()
+	}()
+
+	// The subscriber is registered above under b.mu, so any Publish call
+	// made after ready closes offers its event to it; Publish still drops
+	// the event if this subscriber's buffer is full.
+	if ready != nil {
+		close(ready)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev := <-ch:
+			handler(ev.origin, ev.key)
+		}
+	}
+}
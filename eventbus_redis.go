@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisEventSep separates the origin instance ID from the key in a
+// published message. Instance IDs are always hex, so this cannot collide.
+const redisEventSep = "\x00"
+
+// RedisEventBus is an EventBus backed by Redis pub/sub, letting independent
+// ShieldedCache processes share invalidations over a single Redis channel.
+type RedisEventBus struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedisEventBus creates an EventBus that publishes and subscribes on
+// channel using client.
+func NewRedisEventBus(client *redis.Client, channel string) *RedisEventBus {
+	return &RedisEventBus{client: client, channel: channel}
+}
+
+func (b *RedisEventBus) Publish(ctx context.Context, origin string, key string) error {
+	return b.client.Publish(ctx, b.channel, origin+redisEventSep+key).Err()
+}
+
+func (b *RedisEventBus) Subscribe(ctx context.Context, handler func(origin string, key string), ready chan<- struct{}) error {
+	sub := b.client.Subscribe(ctx, b.channel)
+	defer sub.Close()
+
+	// Receive blocks until Redis confirms the SUBSCRIBE, so only after it
+	// returns is this subscriber guaranteed to observe a Publish.
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+	if ready != nil {
+		close(ready)
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			origin, key, found := strings.Cut(msg.Payload, redisEventSep)
+			if !found {
+				// Not a message we published; ignore rather than abort the
+				// whole subscription over one bad payload.
+				continue
+			}
+
+			handler(origin, key)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package cache
+
+import "container/list"
+
+// EvictionPolicy governs which key a cache shard evicts once the cache as a
+// whole grows beyond MaxEntries. ShieldedCache always calls these methods while holding
+// the owning shard's lock, so implementations do not need to be safe for
+// concurrent use on their own.
+type EvictionPolicy[K comparable] interface {
+	// Touch records that key was just read or inserted.
+	Touch(key K)
+	// Remove forgets key, e.g. after TTL expiry or an explicit delete.
+	Remove(key K)
+	// Evict picks a victim to remove and reports whether one was found.
+	Evict() (key K, ok bool)
+}
+
+// NoEvictionPolicy never evicts a key. It is the default policy, matching
+// the cache's original unbounded behavior.
+type NoEvictionPolicy[K comparable] struct{}
+
+// NewNoEvictionPolicy creates a policy that never picks a victim.
+func NewNoEvictionPolicy[K comparable]() *NoEvictionPolicy[K] {
+	return &NoEvictionPolicy[K]{}
+}
+
+func (p *NoEvictionPolicy[K]) Touch(key K)  {}
+func (p *NoEvictionPolicy[K]) Remove(key K) {}
+func (p *NoEvictionPolicy[K]) Evict() (K, bool) {
+	var zero K
+	return zero, false
+}
+
+// LRUPolicy evicts the least recently touched key.
+type LRUPolicy[K comparable] struct {
+	list     *list.List
+	elements map[K]*list.Element
+}
+
+// NewLRUPolicy creates an empty least-recently-used eviction policy.
+func NewLRUPolicy[K comparable]() *LRUPolicy[K] {
+	return &LRUPolicy[K]{
+		list:     list.New(),
+		elements: make(map[K]*list.Element),
+	}
+}
+
+func (p *LRUPolicy[K]) Touch(key K) {
+	if el, ok := p.elements[key]; ok {
+		p.list.MoveToFront(el)
+		return
+	}
+	p.elements[key] = p.list.PushFront(key)
+}
+
+func (p *LRUPolicy[K]) Remove(key K) {
+	if el, ok := p.elements[key]; ok {
+		p.list.Remove(el)
+		delete(p.elements, key)
+	}
+}
+
+func (p *LRUPolicy[K]) Evict() (K, bool) {
+	el := p.list.Back()
+	if el == nil {
+		var zero K
+		return zero, false
+	}
+	p.list.Remove(el)
+	key := el.Value.(K)
+	delete(p.elements, key)
+	return key, true
+}
+
+// LFUPolicy evicts the least frequently touched key, breaking ties in favor
+// of whichever of them was touched first.
+type LFUPolicy[K comparable] struct {
+	freq  map[K]uint64
+	order []K
+}
+
+// NewLFUPolicy creates an empty least-frequently-used eviction policy.
+func NewLFUPolicy[K comparable]() *LFUPolicy[K] {
+	return &LFUPolicy[K]{freq: make(map[K]uint64)}
+}
+
+func (p *LFUPolicy[K]) Touch(key K) {
+	if _, ok := p.freq[key]; !ok {
+		p.order = append(p.order, key)
+	}
+	p.freq[key]++
+}
+
+func (p *LFUPolicy[K]) Remove(key K) {
+	if _, ok := p.freq[key]; !ok {
+		return
+	}
+	delete(p.freq, key)
+	for i, k := range p.order {
+		if k == key {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (p *LFUPolicy[K]) Evict() (K, bool) {
+	if len(p.order) == 0 {
+		var zero K
+		return zero, false
+	}
+
+	victim := p.order[0]
+	victimFreq := p.freq[victim]
+	for _, key := range p.order[1:] {
+		if f := p.freq[key]; f < victimFreq {
+			victim, victimFreq = key, f
+		}
+	}
+
+	p.Remove(victim)
+	return victim, true
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUPolicyEvictsLeastRecentlyTouched(t *testing.T) {
+	require := require.New(t)
+
+	p := NewLRUPolicy[string]()
+
+	p.Touch("a")
+	p.Touch("b")
+	p.Touch("c")
+
+	// Touching "a" again makes "b" the least recently used.
+	p.Touch("a")
+
+	key, ok := p.Evict()
+	require.True(ok)
+	require.Equal("b", key)
+
+	key, ok = p.Evict()
+	require.True(ok)
+	require.Equal("c", key)
+
+	key, ok = p.Evict()
+	require.True(ok)
+	require.Equal("a", key)
+
+	_, ok = p.Evict()
+	require.False(ok)
+}
+
+func TestLRUPolicyRemove(t *testing.T) {
+	require := require.New(t)
+
+	p := NewLRUPolicy[string]()
+
+	p.Touch("a")
+	p.Touch("b")
+	p.Remove("a")
+
+	key, ok := p.Evict()
+	require.True(ok)
+	require.Equal("b", key)
+
+	_, ok = p.Evict()
+	require.False(ok)
+}
+
+func TestLFUPolicyEvictsLeastFrequentlyTouched(t *testing.T) {
+	require := require.New(t)
+
+	p := NewLFUPolicy[string]()
+
+	p.Touch("a")
+	p.Touch("b")
+	p.Touch("b")
+	p.Touch("c")
+	p.Touch("c")
+	p.Touch("c")
+
+	key, ok := p.Evict()
+	require.True(ok)
+	require.Equal("a", key)
+
+	key, ok = p.Evict()
+	require.True(ok)
+	require.Equal("b", key)
+
+	key, ok = p.Evict()
+	require.True(ok)
+	require.Equal("c", key)
+
+	_, ok = p.Evict()
+	require.False(ok)
+}
+
+func TestNoEvictionPolicyNeverEvicts(t *testing.T) {
+	require := require.New(t)
+
+	p := NewNoEvictionPolicy[string]()
+	p.Touch("a")
+
+	_, ok := p.Evict()
+	require.False(ok)
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+)
+
+const (
+	// defaultShardCount is how many stripes a Cache splits its objects and
+	// shields maps into when WithShardCount is not given.
+	defaultShardCount = 256
+
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// shard is one stripe of a Cache. objects, shields, and the eviction
+// policy all live here rather than on Cache itself, so that Fetch calls
+// hashing to different shards never contend with each other's locks.
+type shard[K comparable, V any] struct {
+	mu      sync.RWMutex
+	objects map[K]CacheEntry[V]
+
+	shieldsMu sync.Mutex
+	shields   map[K]*shieldEntry
+
+	evictionPolicy EvictionPolicy[K]
+}
+
+func newShard[K comparable, V any](newPolicy func() EvictionPolicy[K]) *shard[K, V] {
+	return &shard[K, V]{
+		objects:        make(map[K]CacheEntry[V]),
+		shields:        make(map[K]*shieldEntry),
+		evictionPolicy: newPolicy(),
+	}
+}
+
+// shardFor returns the shard key hashes to.
+func (s *Cache[K, V]) shardFor(key K) *shard[K, V] {
+	return s.shards[s.keyHasher(key)%uint64(len(s.shards))]
+}
+
+// defaultKeyHasher hashes the common comparable key types directly and
+// falls back to hashing key's fmt.Sprint representation for everything
+// else. The common types are hashed without reflection; only the
+// fmt.Sprint fallback formats key via reflection.
+func defaultKeyHasher[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return fnv64([]byte(k))
+	case int:
+		return uint64(k)
+	case int8:
+		return uint64(k)
+	case int16:
+		return uint64(k)
+	case int32:
+		return uint64(k)
+	case int64:
+		return uint64(k)
+	case uint:
+		return uint64(k)
+	case uint8:
+		return uint64(k)
+	case uint16:
+		return uint64(k)
+	case uint32:
+		return uint64(k)
+	case uint64:
+		return k
+	case uintptr:
+		return uint64(k)
+	default:
+		return fnv64([]byte(fmt.Sprint(k)))
+	}
+}
+
+// fnv64 is a dependency-free FNV-1a implementation, used instead of
+// hash/fnv to avoid an allocation per shard lookup.
+func fnv64(b []byte) uint64 {
+	h := uint64(fnvOffset64)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+	return h
+}